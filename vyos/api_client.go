@@ -2,30 +2,126 @@ package vyos
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/rand"
 	"mime/multipart"
 	"net/http"
 	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogFlag is a bitmask of what to emit via tflog for each APIClient request.
+// Modeled after the per-category logging knobs exposed by PAN-OS style clients,
+// so operators can turn on just what they need instead of global TF_LOG=TRACE.
+type LogFlag uint32
+
+const (
+	LogSend LogFlag = 1 << iota
+	LogReceive
+	LogOp
+	LogPath
 )
 
 type APIClient struct {
 	Host   string
 	Apikey string
 	Client *http.Client
+
+	// CommitConfirmTimeout, when non-zero, makes ApplyCommands wrap its apply in
+	// a commit-confirm/confirm pair so a bad change auto-reverts on the router
+	// if the confirm is never sent.
+	CommitConfirmTimeout time.Duration
+	// SaveAfterApply persists the running configuration to config.boot after
+	// every successful ApplyCommands call.
+	SaveAfterApply bool
+	// RollbackOnError issues a compensating delete/set batch derived from the
+	// pre-change configuration when an ApplyCommands call fails partway through.
+	RollbackOnError bool
+
+	// PlanDryRun enables ModifyPlan's plan-time dry run against the router:
+	// existence checks for deletes, ownership conflict detection across
+	// vyosconfig_* resources, and a diff of the planned change.
+	PlanDryRun bool
+	// PathOwners tracks which resource instance claimed which path during the
+	// current plan, shared by all vyosconfig_* resources configured from this client.
+	PathOwners *pathOwnerRegistry
+
+	// MaxRetries, RetryWaitMin and RetryWaitMax control the exponential backoff
+	// applied to idempotent requests that hit a 429/5xx or a transport error.
+	MaxRetries   int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// LogFlags selects which parts of each request/response get logged via tflog.
+	LogFlags LogFlag
+}
+
+// APIClientOptions configures transport-level behavior for NewAPIClient: TLS
+// verification and client certificates, retry tuning, and logging flags.
+type APIClientOptions struct {
+	Insecure      bool
+	CACertPEM     string
+	ClientCertPEM string
+	ClientKeyPEM  string
+
+	MaxRetries   int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	LogFlags LogFlag
 }
 
-func NewAPIClient(host, apikey string) (*APIClient, error) {
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+func NewAPIClient(host, apikey string, opts APIClientOptions) (*APIClient, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: opts.Insecure}
+
+	if opts.CACertPEM != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(opts.CACertPEM)) {
+			return nil, fmt.Errorf("failed to parse ca_cert_pem")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opts.ClientCertPEM != "" || opts.ClientKeyPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(opts.ClientCertPEM), []byte(opts.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse client_cert_pem/client_key_pem: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	maxRetries := opts.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = 3
+	}
+	retryWaitMin := opts.RetryWaitMin
+	if retryWaitMin <= 0 {
+		retryWaitMin = 500 * time.Millisecond
+	}
+	retryWaitMax := opts.RetryWaitMax
+	if retryWaitMax <= 0 {
+		retryWaitMax = 5 * time.Second
 	}
 
 	return &APIClient{
 		Host:   host,
 		Apikey: apikey,
-		Client: &http.Client{Transport: tr},
+		Client: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		MaxRetries:   maxRetries,
+		RetryWaitMin: retryWaitMin,
+		RetryWaitMax: retryWaitMax,
+		LogFlags:     opts.LogFlags,
+		PathOwners:   newPathOwnerRegistry(),
 	}, nil
 }
 
@@ -53,7 +149,58 @@ func GetRoutePrefix(path []string) string {
 	}
 	return ""
 }
-func (c *APIClient) ApplyCommands(commands []Command) error {
+
+func (c *APIClient) ApplyCommands(ctx context.Context, commands []Command) error {
+	var snapshot map[string]map[string]interface{}
+	if c.RollbackOnError {
+		snapshot = c.snapshotNodes(ctx, commands)
+	}
+
+	var err error
+	if c.CommitConfirmTimeout > 0 {
+		err = c.applyConfirmed(ctx, commands)
+	} else {
+		err = c.sendConfigure(ctx, commands)
+	}
+
+	if err != nil {
+		if c.RollbackOnError {
+			if rbErr := c.rollback(ctx, snapshot, commands); rbErr != nil {
+				return fmt.Errorf("erro: %w (rollback also failed: %v)", err, rbErr)
+			}
+		}
+		return err
+	}
+
+	if c.SaveAfterApply {
+		if err := c.Save(ctx); err != nil {
+			return fmt.Errorf("erro ao salvar configuração: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// applyConfirmed sends commands and wraps them in a commit-confirm/confirm pair,
+// so the router automatically reverts if Confirm is never reached (e.g. the apply
+// crashed, or the change broke connectivity to the router itself). Confirm is
+// sent immediately after CommitConfirm succeeds, with no reachability check in
+// between, so this only guards against the change breaking the API path itself;
+// it won't catch a change that breaks connectivity elsewhere in the network.
+func (c *APIClient) applyConfirmed(ctx context.Context, commands []Command) error {
+	if err := c.sendConfigure(ctx, commands); err != nil {
+		return err
+	}
+	if err := c.CommitConfirm(ctx, c.CommitConfirmTimeout); err != nil {
+		return fmt.Errorf("erro on commit-confirm: %w", err)
+	}
+	if err := c.Confirm(ctx); err != nil {
+		return fmt.Errorf("erro confirming commit: %w", err)
+	}
+	return nil
+}
+
+func (c *APIClient) sendConfigure(ctx context.Context, commands []Command) error {
 	endpoint := fmt.Sprintf("%s/configure", c.Host)
 
 	payload := map[string]interface{}{
@@ -70,6 +217,7 @@ func (c *APIClient) ApplyCommands(commands []Command) error {
 			command["value"] = cmd.Value
 		}
 		payload["commands"] = append(payload["commands"].([]map[string]interface{}), command)
+		c.logOp(ctx, cmd.Op, cmd.Path)
 	}
 
 	jsonPayload, err := json.Marshal(payload)
@@ -77,13 +225,53 @@ func (c *APIClient) ApplyCommands(commands []Command) error {
 		return fmt.Errorf("erro: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(jsonPayload))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonPayload))
 	if err != nil {
 		return fmt.Errorf("erro to create a request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.Client.Do(req)
+	// Only batches made up entirely of deletes are treated as idempotent and
+	// retried automatically: a retried delete of an already-deleted path is
+	// harmless, but a retried set that raced with a concurrent change may not be.
+	idempotent := true
+	for _, cmd := range commands {
+		if cmd.Op != "delete" {
+			idempotent = false
+			break
+		}
+	}
+
+	resp, err := c.do(ctx, req, idempotent)
+	if err != nil {
+		return fmt.Errorf("erro: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("erro (%d): %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// doOp posts a simple {"op": op, ...extra} request, used by the commit/save/
+// rollback family of endpoints that don't carry a command list.
+func (c *APIClient) doOp(ctx context.Context, endpoint, op string, extra map[string]interface{}) error {
+	requestData := map[string]interface{}{"op": op}
+	for k, v := range extra {
+		requestData[k] = v
+	}
+
+	c.logOp(ctx, op, nil)
+
+	formData := map[string]string{
+		"data": mustMarshal(requestData),
+		"key":  c.Apikey,
+	}
+
+	resp, err := c.postForm(ctx, endpoint, formData, true)
 	if err != nil {
 		return fmt.Errorf("erro: %w", err)
 	}
@@ -97,19 +285,114 @@ func (c *APIClient) ApplyCommands(commands []Command) error {
 	return nil
 }
 
-func (c *APIClient) GetCurrentConfig(path []string) (map[string]interface{}, error) {
+// Commit commits the pending configuration session permanently.
+func (c *APIClient) Commit(ctx context.Context) error {
+	return c.doOp(ctx, fmt.Sprintf("%s/config-file", c.Host), "commit", nil)
+}
+
+// CommitConfirm commits the pending configuration session but automatically
+// reverts it unless Confirm is called within timeout. The router takes the
+// timeout in whole minutes, so timeout is rounded down, with a minimum of 1.
+func (c *APIClient) CommitConfirm(ctx context.Context, timeout time.Duration) error {
+	minutes := int(timeout.Minutes())
+	if minutes < 1 {
+		minutes = 1
+	}
+	return c.doOp(ctx, fmt.Sprintf("%s/config-file", c.Host), "commitconfirm", map[string]interface{}{"minutes": minutes})
+}
+
+// Confirm acknowledges a prior CommitConfirm so the router keeps the change.
+func (c *APIClient) Confirm(ctx context.Context) error {
+	return c.doOp(ctx, fmt.Sprintf("%s/reset", c.Host), "confirm", nil)
+}
+
+// Save persists the running configuration to config.boot so it survives a reboot.
+func (c *APIClient) Save(ctx context.Context) error {
+	return c.doOp(ctx, fmt.Sprintf("%s/config-file", c.Host), "save", map[string]interface{}{"file": "config.boot"})
+}
+
+// Discard drops any uncommitted changes in the current configuration session.
+func (c *APIClient) Discard(ctx context.Context) error {
+	return c.doOp(ctx, fmt.Sprintf("%s/config-file", c.Host), "discard", nil)
+}
+
+func parentPath(path []string) []string {
+	if len(path) == 0 {
+		return path
+	}
+	return path[:len(path)-1]
+}
+
+// snapshotNodes captures the pre-change configuration of every unique path
+// touched by commands (the node being mutated, not its parent), so rollback
+// can restore exactly that node without touching unrelated siblings living
+// under the same parent (e.g. other interfaces, other firewall rules).
+func (c *APIClient) snapshotNodes(ctx context.Context, commands []Command) map[string]map[string]interface{} {
+	snapshot := make(map[string]map[string]interface{})
+	seen := make(map[string]bool)
+
+	for _, cmd := range commands {
+		key := strings.Join(cmd.Path, ":")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		config, err := c.GetCurrentConfig(ctx, cmd.Path)
+		if err != nil {
+			continue
+		}
+		snapshot[key] = config
+	}
+
+	return snapshot
+}
+
+// rollback restores the paths touched by commands to the state captured in
+// snapshot, by deleting each path and recursively re-setting whatever
+// children it had via flattenConfig. Operating on the command's own path,
+// rather than its parent, keeps the compensating batch scoped to the node
+// that was actually being mutated.
+func (c *APIClient) rollback(ctx context.Context, snapshot map[string]map[string]interface{}, commands []Command) error {
+	compensating := make([]Command, 0)
+	seen := make(map[string]bool)
+
+	for _, cmd := range commands {
+		key := strings.Join(cmd.Path, ":")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		compensating = append(compensating, Command{Op: "delete", Path: cmd.Path})
+
+		before, ok := snapshot[key]
+		if !ok || len(before) == 0 {
+			continue
+		}
+		compensating = append(compensating, flattenConfig(cmd.Path, before)...)
+	}
+
+	if len(compensating) == 0 {
+		return nil
+	}
+	return c.sendConfigure(ctx, compensating)
+}
+
+func (c *APIClient) GetCurrentConfig(ctx context.Context, path []string) (map[string]interface{}, error) {
 	endpoint := fmt.Sprintf("%s/retrieve", c.Host)
 	requestData := map[string]interface{}{
 		"op":   "showConfig",
 		"path": path,
 	}
+	c.logOp(ctx, "showConfig", path)
 
 	formData := map[string]string{
 		"data": mustMarshal(requestData),
 		"key":  c.Apikey,
 	}
 
-	resp, err := c.postForm(endpoint, formData)
+	resp, err := c.postForm(ctx, endpoint, formData, true)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +408,7 @@ func (c *APIClient) GetCurrentConfig(path []string) (map[string]interface{}, err
 	return result.Data, nil
 }
 
-func (c *APIClient) postForm(url string, data map[string]string) (*http.Response, error) {
+func (c *APIClient) postForm(ctx context.Context, url string, data map[string]string, idempotent bool) (*http.Response, error) {
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
 
@@ -143,13 +426,112 @@ func (c *APIClient) postForm(url string, data map[string]string) (*http.Response
 
 	writer.Close()
 
-	req, err := http.NewRequest("POST", url, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("Content-Type", writer.FormDataContentType())
-	return c.Client.Do(req)
+	return c.do(ctx, req, idempotent)
+}
+
+// do sends req, retrying idempotent requests with exponential backoff and
+// jitter on transport errors and 429/5xx responses, and emits tflog entries
+// per c.LogFlags with the API key redacted.
+func (c *APIClient) do(ctx context.Context, req *http.Request, idempotent bool) (*http.Response, error) {
+	maxRetries := c.MaxRetries
+	if maxRetries < 1 {
+		maxRetries = 1
+	}
+
+	var bodyBytes []byte
+	if req.Body != nil {
+		bodyBytes, _ = io.ReadAll(req.Body)
+		req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		if attempt > 0 {
+			req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		}
+
+		c.logSend(ctx, req.Method, req.URL.String(), bodyBytes)
+
+		resp, err := c.Client.Do(req)
+		if err != nil {
+			lastErr = err
+			if !idempotent || attempt == maxRetries-1 {
+				return nil, err
+			}
+			c.backoff(attempt)
+			continue
+		}
+
+		if (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500) && idempotent && attempt < maxRetries-1 {
+			respBody, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			c.logReceive(ctx, resp.StatusCode, respBody)
+			lastErr = fmt.Errorf("retryable status %d", resp.StatusCode)
+			c.backoff(attempt)
+			continue
+		}
+
+		respBody, _ := io.ReadAll(resp.Body)
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		c.logReceive(ctx, resp.StatusCode, respBody)
+		return resp, nil
+	}
+
+	return nil, lastErr
+}
+
+func (c *APIClient) backoff(attempt int) {
+	wait := c.RetryWaitMin << attempt
+	if wait <= 0 || wait > c.RetryWaitMax {
+		wait = c.RetryWaitMax
+	}
+	jitter := time.Duration(rand.Int63n(int64(wait/2) + 1))
+	time.Sleep(wait/2 + jitter)
+}
+
+func (c *APIClient) logOp(ctx context.Context, op string, path []string) {
+	if c.LogFlags&LogOp != 0 {
+		tflog.Debug(ctx, "vyosconfig api op", map[string]interface{}{"op": op})
+	}
+	if c.LogFlags&LogPath != 0 && len(path) > 0 {
+		tflog.Debug(ctx, "vyosconfig api path", map[string]interface{}{"path": strings.Join(path, " ")})
+	}
+}
+
+func (c *APIClient) logSend(ctx context.Context, method, url string, body []byte) {
+	if c.LogFlags&LogSend == 0 {
+		return
+	}
+	tflog.Debug(ctx, "vyosconfig api request", map[string]interface{}{
+		"method": method,
+		"url":    url,
+		"body":   c.redact(string(body)),
+	})
+}
+
+func (c *APIClient) logReceive(ctx context.Context, status int, body []byte) {
+	if c.LogFlags&LogReceive == 0 {
+		return
+	}
+	tflog.Debug(ctx, "vyosconfig api response", map[string]interface{}{
+		"status": status,
+		"body":   c.redact(string(body)),
+	})
+}
+
+// redact replaces every occurrence of the API key with a placeholder so it
+// never ends up in provider logs.
+func (c *APIClient) redact(s string) string {
+	if c.Apikey == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, c.Apikey, "***")
 }
 
 func parseAPIResponse(resp *http.Response) error {
@@ -174,19 +556,20 @@ func mustMarshal(v interface{}) string {
 	return string(b)
 }
 
-func (c *APIClient) PathExists(path []string) (bool, error) {
+func (c *APIClient) PathExists(ctx context.Context, path []string) (bool, error) {
 	endpoint := fmt.Sprintf("%s/retrieve", c.Host)
 	requestData := map[string]interface{}{
 		"op":   "exists",
 		"path": path,
 	}
+	c.logOp(ctx, "exists", path)
 
 	formData := map[string]string{
 		"data": mustMarshal(requestData),
 		"key":  c.Apikey,
 	}
 
-	resp, err := c.postForm(endpoint, formData)
+	resp, err := c.postForm(ctx, endpoint, formData, true)
 	if err != nil {
 		return false, err
 	}
@@ -202,12 +585,12 @@ func (c *APIClient) PathExists(path []string) (bool, error) {
 	return result.Data, nil
 }
 
-func (c *APIClient) CountNextHops(routePath []string) (int, error) {
+func (c *APIClient) CountNextHops(ctx context.Context, routePath []string) (int, error) {
 	if len(routePath) != 4 || routePath[0] != "protocols" || routePath[1] != "static" || routePath[2] != "route" {
 		return 0, fmt.Errorf("invalid path: %v", routePath)
 	}
 
-	config, err := c.GetCurrentConfig(append(routePath, "next-hop"))
+	config, err := c.GetCurrentConfig(ctx, append(routePath, "next-hop"))
 	if err != nil {
 		return 0, err
 	}
@@ -218,13 +601,13 @@ func (c *APIClient) CountNextHops(routePath []string) (int, error) {
 	return 0, nil
 }
 
-func (c *APIClient) GetPathValue(path []string) (string, error) {
+func (c *APIClient) GetPathValue(ctx context.Context, path []string) (string, error) {
 	if IsRoutePath(path) && len(path) >= 5 && path[len(path)-2] == "next-hop" {
 		return path[len(path)-1], nil
 	}
 
 	if IsRoutePath(path) && len(path) >= 4 && path[len(path)-1] == "next-hop" {
-		config, err := c.GetCurrentConfig(path)
+		config, err := c.GetCurrentConfig(ctx, path)
 		if err != nil {
 			return "", err
 		}
@@ -240,7 +623,7 @@ func (c *APIClient) GetPathValue(path []string) (string, error) {
 		}
 	}
 
-	config, err := c.GetCurrentConfig(path)
+	config, err := c.GetCurrentConfig(ctx, path)
 	if err != nil {
 		return "", err
 	}
@@ -258,12 +641,13 @@ func (c *APIClient) GetPathValue(path []string) (string, error) {
 
 	return extractConfigValue(config), nil
 }
-func (c *APIClient) GetNextHops(routePath []string) ([]string, error) {
+
+func (c *APIClient) GetNextHops(ctx context.Context, routePath []string) ([]string, error) {
 	if len(routePath) != 4 || routePath[0] != "protocols" || routePath[1] != "static" || routePath[2] != "route" {
 		return nil, fmt.Errorf("invalid path: %v", routePath)
 	}
 
-	config, err := c.GetCurrentConfig(append(routePath, "next-hop"))
+	config, err := c.GetCurrentConfig(ctx, append(routePath, "next-hop"))
 	if err != nil {
 		return nil, err
 	}
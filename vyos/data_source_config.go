@@ -0,0 +1,111 @@
+package vyos
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vyosConfigDataSource struct {
+	client *APIClient
+}
+
+func NewVyosConfigDataSource() datasource.DataSource {
+	return &vyosConfigDataSource{}
+}
+
+type vyosConfigDataSourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Path   types.List   `tfsdk:"path"`
+	JSON   types.String `tfsdk:"json"`
+	Keys   types.List   `tfsdk:"keys"`
+	Values types.Map    `tfsdk:"values"`
+}
+
+func (d *vyosConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "vyosconfig_config"
+}
+
+func (d *vyosConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a subtree of the running VyOS configuration via `showConfig`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The requested path, joined with ':'",
+			},
+			"path": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Configuration path to retrieve, e.g. [\"interfaces\", \"ethernet\", \"eth0\"]",
+			},
+			"json": schema.StringAttribute{
+				Computed:    true,
+				Description: "The full subtree at path, jsonencoded",
+			},
+			"keys": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Sorted top-level keys present at path",
+			},
+			"values": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Every top-level child of path, stringified: scalars as-is, lists as a sorted JSON array, nested objects as JSON (or, for an object with a single entry, that entry's key or value). The full subtree is available via json.",
+			},
+		},
+	}
+}
+
+func (d *vyosConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*APIClient)
+}
+
+func (d *vyosConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data vyosConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pathParts := toStringSlice(data.Path)
+
+	config, err := d.client.GetCurrentConfig(ctx, pathParts)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read configuration", err.Error())
+		return
+	}
+
+	jsonBytes, err := json.Marshal(config)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode configuration", err.Error())
+		return
+	}
+	data.JSON = types.StringValue(string(jsonBytes))
+	data.ID = types.StringValue(makePathKey(pathParts))
+
+	keys := make([]string, 0, len(config))
+	values := make(map[string]string, len(config))
+	for key, value := range config {
+		keys = append(keys, key)
+		values[key] = extractConfigValue(value)
+	}
+	sort.Strings(keys)
+
+	keysList, diags := types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	data.Keys = keysList
+
+	valuesMap, diags := types.MapValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	data.Values = valuesMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
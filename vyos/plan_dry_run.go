@@ -0,0 +1,110 @@
+package vyos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// privateOwnerKey is the private-state key ModifyPlan stores each resource
+// instance's owner id under, so it survives from the plan-phase ModifyPlan
+// call to the apply-phase one.
+const privateOwnerKey = "vyosconfig_plan_owner_id"
+
+// pathOwnerRegistry tracks, for the lifetime of a single terraform plan/apply
+// (i.e. for as long as the APIClient that owns it is alive), which tracked
+// vyosconfig_* resource instance claimed which configuration path. It lets
+// ModifyPlan raise an error when two resources in the same plan would set the
+// same path, instead of silently letting the second apply clobber the first.
+type pathOwnerRegistry struct {
+	mu      sync.Mutex
+	owners  map[string]string
+	counter int
+}
+
+func newPathOwnerRegistry() *pathOwnerRegistry {
+	return &pathOwnerRegistry{owners: make(map[string]string)}
+}
+
+// nextOwnerID returns a fresh identifier for a resource instance going through
+// ModifyPlan, used as the claimant in claim().
+func (reg *pathOwnerRegistry) nextOwnerID(resourceType string) string {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.counter++
+	return fmt.Sprintf("%s#%d", resourceType, reg.counter)
+}
+
+// modifyPlanOwnerID returns a stable owner id for the resource instance going
+// through req/resp's ModifyPlan call. terraform-plugin-framework calls
+// ModifyPlan once during the plan phase and again during the apply phase for
+// the same resource instance; without persisting the id across the two
+// calls, each would mint a different owner and claim() would flag the
+// apply-phase call as a conflict with the plan-phase call it followed. The id
+// is stored in private plan state on first mint and reused from there after.
+func modifyPlanOwnerID(ctx context.Context, registry *pathOwnerRegistry, resourceType string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) string {
+	existing, diags := req.Private.GetKey(ctx, privateOwnerKey)
+	resp.Diagnostics.Append(diags...)
+	if len(existing) > 0 {
+		return string(existing)
+	}
+
+	owner := registry.nextOwnerID(resourceType)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateOwnerKey, []byte(owner))...)
+	return owner
+}
+
+// claim registers owner as the claimant of pathKey. If pathKey was already
+// claimed by a different owner, it returns that owner and ok=false without
+// changing the registry.
+func (reg *pathOwnerRegistry) claim(pathKey, owner string) (conflictOwner string, ok bool) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	if existing, found := reg.owners[pathKey]; found && existing != owner {
+		return existing, false
+	}
+	reg.owners[pathKey] = owner
+	return "", true
+}
+
+// unifiedDiff renders a minimal added/removed-lines diff between the
+// jsonencoded forms of before and after, for surfacing in plan output.
+func unifiedDiff(before, after map[string]interface{}) string {
+	beforeLines := jsonLines(before)
+	afterLines := jsonLines(after)
+
+	afterSet := make(map[string]bool, len(afterLines))
+	for _, line := range afterLines {
+		afterSet[line] = true
+	}
+	beforeSet := make(map[string]bool, len(beforeLines))
+	for _, line := range beforeLines {
+		beforeSet[line] = true
+	}
+
+	var b strings.Builder
+	for _, line := range beforeLines {
+		if !afterSet[line] {
+			b.WriteString("- " + line + "\n")
+		}
+	}
+	for _, line := range afterLines {
+		if !beforeSet[line] {
+			b.WriteString("+ " + line + "\n")
+		}
+	}
+	return b.String()
+}
+
+func jsonLines(v map[string]interface{}) []string {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil
+	}
+	return strings.Split(string(b), "\n")
+}
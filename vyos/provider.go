@@ -2,6 +2,7 @@ package vyos
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -31,14 +32,90 @@ func (p *VyosProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Required:    true,
 				Description: "ApiKEy",
 			},
+			"commit_confirm_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Seconds before an unconfirmed commit is automatically reverted by the router, rounded down to whole minutes (minimum 1 minute). When set, Create/Update/Delete wrap their apply in a commit-confirm/confirm pair. Confirm is sent right after the commit succeeds, with no reachability check in between, so this only protects against a change breaking the router's API itself (e.g. the interface or firewall rule the provider talks over); it will not catch a change that breaks connectivity elsewhere (a different interface, an unrelated route).",
+			},
+			"save_after_apply": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Persist the running configuration to config.boot after every successful apply.",
+			},
+			"rollback_on_error": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Issue a compensating delete/set batch derived from the pre-change configuration if an apply fails partway through.",
+			},
+			"insecure": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip TLS certificate verification. Defaults to false; set ca_cert_pem instead when possible.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded CA certificate used to verify the router's TLS certificate.",
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded client certificate for mutual TLS, used together with client_key_pem.",
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded client private key for mutual TLS, used together with client_cert_pem.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of attempts for idempotent requests that hit a 429/5xx response or a transport error. Defaults to 3.",
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum backoff, in seconds, between retries. Defaults to 0.5s, doubling on each attempt up to retry_wait_max.",
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum backoff, in seconds, between retries. Defaults to 5s.",
+			},
+			"log_send": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Log outgoing API requests (with the API key redacted) via tflog.",
+			},
+			"log_receive": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Log API responses via tflog.",
+			},
+			"log_op": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Log the VyOS operation (set/delete/showConfig/...) of each request via tflog.",
+			},
+			"log_path": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Log the VyOS configuration path of each request via tflog.",
+			},
+			"plan_dry_run": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Check planned set/delete paths against the router during ModifyPlan: warn on deletes of non-existent paths, error on paths claimed by more than one vyosconfig_* resource in the same plan, and surface a diff of the planned change. The router checks and the diff apply to vyosconfig_command resources; the typed resources only take part in the conflict check. Adds a round trip to the router per set/delete command of each vyosconfig_command resource, so large configs may want to opt out.",
+			},
 		},
 	}
 }
 
 func (p *VyosProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config struct {
-		Host   string `tfsdk:"host"`
-		Apikey string `tfsdk:"apikey"`
+		Host                 string  `tfsdk:"host"`
+		Apikey               string  `tfsdk:"apikey"`
+		CommitConfirmTimeout *int64  `tfsdk:"commit_confirm_timeout"`
+		SaveAfterApply       *bool   `tfsdk:"save_after_apply"`
+		RollbackOnError      *bool   `tfsdk:"rollback_on_error"`
+		Insecure             *bool   `tfsdk:"insecure"`
+		CACertPEM            *string `tfsdk:"ca_cert_pem"`
+		ClientCertPEM        *string `tfsdk:"client_cert_pem"`
+		ClientKeyPEM         *string `tfsdk:"client_key_pem"`
+		MaxRetries           *int64  `tfsdk:"max_retries"`
+		RetryWaitMin         *int64  `tfsdk:"retry_wait_min"`
+		RetryWaitMax         *int64  `tfsdk:"retry_wait_max"`
+		LogSend              *bool   `tfsdk:"log_send"`
+		LogReceive           *bool   `tfsdk:"log_receive"`
+		LogOp                *bool   `tfsdk:"log_op"`
+		LogPath              *bool   `tfsdk:"log_path"`
+		PlanDryRun           *bool   `tfsdk:"plan_dry_run"`
 	}
 
 	diags := req.Config.Get(ctx, &config)
@@ -47,12 +124,60 @@ func (p *VyosProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	client, err := NewAPIClient(config.Host, config.Apikey)
+	opts := APIClientOptions{}
+	if config.Insecure != nil {
+		opts.Insecure = *config.Insecure
+	}
+	if config.CACertPEM != nil {
+		opts.CACertPEM = *config.CACertPEM
+	}
+	if config.ClientCertPEM != nil {
+		opts.ClientCertPEM = *config.ClientCertPEM
+	}
+	if config.ClientKeyPEM != nil {
+		opts.ClientKeyPEM = *config.ClientKeyPEM
+	}
+	if config.MaxRetries != nil {
+		opts.MaxRetries = int(*config.MaxRetries)
+	}
+	if config.RetryWaitMin != nil {
+		opts.RetryWaitMin = time.Duration(*config.RetryWaitMin) * time.Second
+	}
+	if config.RetryWaitMax != nil {
+		opts.RetryWaitMax = time.Duration(*config.RetryWaitMax) * time.Second
+	}
+	if config.LogSend != nil && *config.LogSend {
+		opts.LogFlags |= LogSend
+	}
+	if config.LogReceive != nil && *config.LogReceive {
+		opts.LogFlags |= LogReceive
+	}
+	if config.LogOp != nil && *config.LogOp {
+		opts.LogFlags |= LogOp
+	}
+	if config.LogPath != nil && *config.LogPath {
+		opts.LogFlags |= LogPath
+	}
+
+	client, err := NewAPIClient(config.Host, config.Apikey, opts)
 	if err != nil {
 		resp.Diagnostics.AddError("Erro to create API client", err.Error())
 		return
 	}
 
+	if config.CommitConfirmTimeout != nil {
+		client.CommitConfirmTimeout = time.Duration(*config.CommitConfirmTimeout) * time.Second
+	}
+	if config.SaveAfterApply != nil {
+		client.SaveAfterApply = *config.SaveAfterApply
+	}
+	if config.RollbackOnError != nil {
+		client.RollbackOnError = *config.RollbackOnError
+	}
+	if config.PlanDryRun != nil {
+		client.PlanDryRun = *config.PlanDryRun
+	}
+
 	resp.ResourceData = client
 	resp.DataSourceData = client
 }
@@ -60,9 +185,15 @@ func (p *VyosProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 func (p *VyosProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewVyosConfigResource,
+		NewVyosInterfaceEthernetResource,
+		NewVyosStaticRouteResource,
+		NewVyosFirewallRuleResource,
+		NewVyosNatRuleResource,
 	}
 }
 
 func (p *VyosProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewVyosConfigDataSource,
+	}
 }
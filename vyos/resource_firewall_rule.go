@@ -0,0 +1,277 @@
+package vyos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vyosFirewallRuleResource struct {
+	client *APIClient
+}
+
+func NewVyosFirewallRuleResource() resource.Resource {
+	return &vyosFirewallRuleResource{}
+}
+
+type vyosFirewallRuleModel struct {
+	ID                 types.String `tfsdk:"id"`
+	RuleSet            types.String `tfsdk:"rule_set"`
+	RuleNumber         types.Int64  `tfsdk:"rule_number"`
+	Action             types.String `tfsdk:"action"`
+	Protocol           types.String `tfsdk:"protocol"`
+	SourceAddress      types.String `tfsdk:"source_address"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	DestinationPort    types.String `tfsdk:"destination_port"`
+	Description        types.String `tfsdk:"description"`
+}
+
+func (r *vyosFirewallRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "vyosconfig_firewall_rule"
+}
+
+func (r *vyosFirewallRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single `firewall name <rule-set> rule <number>` entry.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"rule_set": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the firewall rule set, e.g. WAN_IN",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rule_number": schema.Int64Attribute{
+				Required:    true,
+				Description: "Rule number within the rule set",
+			},
+			"action": schema.StringAttribute{
+				Required:    true,
+				Description: "Rule action: accept, drop or reject",
+			},
+			"protocol": schema.StringAttribute{
+				Optional:    true,
+				Description: "Protocol to match, e.g. tcp, udp, icmp",
+			},
+			"source_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source CIDR to match",
+				Validators:  []validator.String{isCIDR()},
+			},
+			"destination_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Destination CIDR to match",
+				Validators:  []validator.String{isCIDR()},
+			},
+			"destination_port": schema.StringAttribute{
+				Optional:    true,
+				Description: "Destination port or port range, e.g. 443 or 8000-8080",
+				Validators:  []validator.String{isPortOrRange()},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Rule description",
+			},
+		},
+	}
+}
+
+func (r *vyosFirewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*APIClient)
+}
+
+func (r *vyosFirewallRuleResource) path(ruleSet string, ruleNumber int64) []string {
+	return []string{"firewall", "name", ruleSet, "rule", fmt.Sprintf("%d", ruleNumber)}
+}
+
+func (r *vyosFirewallRuleResource) commandsForPlan(plan vyosFirewallRuleModel) []Command {
+	base := r.path(plan.RuleSet.ValueString(), plan.RuleNumber.ValueInt64())
+	commands := []Command{
+		{Op: "set", Path: append(append([]string{}, base...), "action"), Value: plan.Action.ValueString()},
+	}
+
+	if !plan.Protocol.IsNull() && plan.Protocol.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "protocol"), Value: plan.Protocol.ValueString()})
+	}
+
+	if !plan.SourceAddress.IsNull() && plan.SourceAddress.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "source", "address"), Value: plan.SourceAddress.ValueString()})
+	}
+
+	if !plan.DestinationAddress.IsNull() && plan.DestinationAddress.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "destination", "address"), Value: plan.DestinationAddress.ValueString()})
+	}
+
+	if !plan.DestinationPort.IsNull() && plan.DestinationPort.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "destination", "port"), Value: plan.DestinationPort.ValueString()})
+	}
+
+	if !plan.Description.IsNull() && plan.Description.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "description"), Value: plan.Description.ValueString()})
+	}
+
+	return commands
+}
+
+func (r *vyosFirewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vyosFirewallRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ApplyCommands(ctx, r.commandsForPlan(plan)); err != nil {
+		resp.Diagnostics.AddError("Failed to apply firewall rule configuration", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(makePathKey(r.path(plan.RuleSet.ValueString(), plan.RuleNumber.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *vyosFirewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vyosFirewallRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	path := r.path(state.RuleSet.ValueString(), state.RuleNumber.ValueInt64())
+	exists, err := r.client.PathExists(ctx, path)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Error checking firewall rule existence", err.Error())
+		return
+	}
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	config, err := r.client.GetCurrentConfig(ctx, path)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read firewall rule configuration", err.Error())
+		return
+	}
+
+	state.Action = stringOrNull(config["action"])
+	state.Protocol = stringOrNull(config["protocol"])
+	state.Description = stringOrNull(config["description"])
+	state.SourceAddress = stringOrNull(getNestedValue(config, []string{"source", "address"}))
+	state.DestinationAddress = stringOrNull(getNestedValue(config, []string{"destination", "address"}))
+	state.DestinationPort = stringOrNull(getNestedValue(config, []string{"destination", "port"}))
+	state.ID = types.StringValue(makePathKey(path))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *vyosFirewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vyosFirewallRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oldPath := r.path(state.RuleSet.ValueString(), state.RuleNumber.ValueInt64())
+	if err := r.client.ApplyCommands(ctx, []Command{{Op: "delete", Path: oldPath}}); err != nil {
+		resp.Diagnostics.AddError("Failed to clear previous firewall rule configuration", err.Error())
+		return
+	}
+
+	if err := r.client.ApplyCommands(ctx, r.commandsForPlan(plan)); err != nil {
+		resp.Diagnostics.AddError("Failed to apply firewall rule configuration", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(makePathKey(r.path(plan.RuleSet.ValueString(), plan.RuleNumber.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// ModifyPlan claims this rule's path in the shared plan-time registry when
+// the provider has plan_dry_run enabled, so it conflicts loudly instead of
+// silently if another vyosconfig_* resource targets the same rule.
+func (r *vyosFirewallRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !r.client.PlanDryRun || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vyosFirewallRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.RuleSet.IsUnknown() || plan.RuleSet.IsNull() || plan.RuleNumber.IsUnknown() || plan.RuleNumber.IsNull() {
+		return
+	}
+
+	path := r.path(plan.RuleSet.ValueString(), plan.RuleNumber.ValueInt64())
+	owner := modifyPlanOwnerID(ctx, r.client.PathOwners, "vyosconfig_firewall_rule", req, resp)
+	if conflictOwner, ok := r.client.PathOwners.claim(makePathKey(path), owner); !ok {
+		resp.Diagnostics.AddError(
+			"Configuration path claimed by more than one resource",
+			fmt.Sprintf("%s is already being managed by %s in this plan.", strings.Join(path, " "), conflictOwner),
+		)
+	}
+}
+
+// ImportState imports a firewall rule via "<rule_set>/<rule_number>", e.g.
+// `terraform import vyosconfig_firewall_rule.r WAN_IN/100`.
+func (r *vyosFirewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ruleSet, ruleNumber, err := splitRuleImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_set"), ruleSet)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_number"), ruleNumber)...)
+}
+
+func splitRuleImportID(id string) (string, int64, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return "", 0, fmt.Errorf("expected \"<rule-set>/<rule-number>\", got %q", id)
+	}
+
+	ruleNumber, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("rule number %q is not an integer: %w", parts[1], err)
+	}
+
+	return parts[0], ruleNumber, nil
+}
+
+func (r *vyosFirewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vyosFirewallRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	path := r.path(state.RuleSet.ValueString(), state.RuleNumber.ValueInt64())
+	if err := r.client.ApplyCommands(ctx, []Command{{Op: "delete", Path: path}}); err != nil {
+		resp.Diagnostics.AddError("Failed to delete firewall rule configuration", err.Error())
+	}
+}
+
+func stringOrNull(v interface{}) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	if s, ok := v.(string); ok {
+		return types.StringValue(s)
+	}
+	return types.StringValue(fmt.Sprintf("%v", v))
+}
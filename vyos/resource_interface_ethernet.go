@@ -0,0 +1,264 @@
+package vyos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vyosInterfaceEthernetResource struct {
+	client *APIClient
+}
+
+func NewVyosInterfaceEthernetResource() resource.Resource {
+	return &vyosInterfaceEthernetResource{}
+}
+
+type vyosInterfaceEthernetModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Address     types.List   `tfsdk:"address"`
+	Description types.String `tfsdk:"description"`
+	Duplex      types.String `tfsdk:"duplex"`
+	Speed       types.String `tfsdk:"speed"`
+	Disable     types.Bool   `tfsdk:"disable"`
+}
+
+func (r *vyosInterfaceEthernetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "vyosconfig_interface_ethernet"
+}
+
+func (r *vyosInterfaceEthernetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single `interfaces ethernet <name>` configuration node.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Interface name, e.g. eth0",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "CIDR addresses assigned to the interface",
+				Validators:  []validator.List{isCIDRList()},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Interface description",
+			},
+			"duplex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Duplex mode (auto, half, full)",
+			},
+			"speed": schema.StringAttribute{
+				Optional:    true,
+				Description: "Interface speed (auto or a fixed value in Mbit/s)",
+			},
+			"disable": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Administratively disable the interface",
+			},
+		},
+	}
+}
+
+func (r *vyosInterfaceEthernetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*APIClient)
+}
+
+func (r *vyosInterfaceEthernetResource) path(name string) []string {
+	return []string{"interfaces", "ethernet", name}
+}
+
+func (r *vyosInterfaceEthernetResource) commandsForPlan(ctx context.Context, plan vyosInterfaceEthernetModel) []Command {
+	base := r.path(plan.Name.ValueString())
+	commands := make([]Command, 0)
+
+	for _, addr := range toStringSlice(plan.Address) {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "address"), Value: addr})
+	}
+
+	if !plan.Description.IsNull() && plan.Description.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "description"), Value: plan.Description.ValueString()})
+	}
+
+	if !plan.Duplex.IsNull() && plan.Duplex.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "duplex"), Value: plan.Duplex.ValueString()})
+	}
+
+	if !plan.Speed.IsNull() && plan.Speed.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "speed"), Value: plan.Speed.ValueString()})
+	}
+
+	if plan.Disable.ValueBool() {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "disable")})
+	}
+
+	return commands
+}
+
+func (r *vyosInterfaceEthernetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vyosInterfaceEthernetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commands := r.commandsForPlan(ctx, plan)
+	if err := r.client.ApplyCommands(ctx, commands); err != nil {
+		resp.Diagnostics.AddError("Failed to apply ethernet interface configuration", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(interfacePathKey(plan.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func interfacePathKey(name string) string {
+	return makePathKey([]string{"interfaces", "ethernet", name})
+}
+
+func (r *vyosInterfaceEthernetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vyosInterfaceEthernetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	exists, err := r.client.PathExists(ctx, r.path(name))
+	if err != nil {
+		resp.Diagnostics.AddWarning("Error checking interface existence", err.Error())
+		return
+	}
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	config, err := r.client.GetCurrentConfig(ctx, r.path(name))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read ethernet interface configuration", err.Error())
+		return
+	}
+
+	state.Description = types.StringNull()
+	if desc, ok := config["description"].(string); ok {
+		state.Description = types.StringValue(desc)
+	}
+
+	state.Duplex = types.StringNull()
+	if duplex, ok := config["duplex"].(string); ok {
+		state.Duplex = types.StringValue(duplex)
+	}
+
+	state.Speed = types.StringNull()
+	if speed, ok := config["speed"].(string); ok {
+		state.Speed = types.StringValue(speed)
+	}
+
+	_, disabled := config["disable"]
+	state.Disable = types.BoolValue(disabled)
+
+	addresses := make([]string, 0)
+	if raw, ok := config["address"].([]interface{}); ok {
+		for _, a := range raw {
+			addresses = append(addresses, fmt.Sprintf("%v", a))
+		}
+	} else if raw, ok := config["address"].(string); ok {
+		addresses = append(addresses, raw)
+	}
+	addrList, diags := types.ListValueFrom(ctx, types.StringType, addresses)
+	resp.Diagnostics.Append(diags...)
+	state.Address = addrList
+
+	state.ID = types.StringValue(interfacePathKey(name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *vyosInterfaceEthernetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vyosInterfaceEthernetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ApplyCommands(ctx, []Command{{Op: "delete", Path: r.path(state.Name.ValueString())}}); err != nil {
+		resp.Diagnostics.AddError("Failed to clear previous ethernet interface configuration", err.Error())
+		return
+	}
+
+	commands := r.commandsForPlan(ctx, plan)
+	if err := r.client.ApplyCommands(ctx, commands); err != nil {
+		resp.Diagnostics.AddError("Failed to apply ethernet interface configuration", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(interfacePathKey(plan.Name.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// ModifyPlan claims this interface's path in the shared plan-time registry
+// when the provider has plan_dry_run enabled, so it conflicts loudly instead
+// of silently if another vyosconfig_* resource targets the same interface.
+func (r *vyosInterfaceEthernetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !r.client.PlanDryRun || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vyosInterfaceEthernetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.Name.IsNull() {
+		return
+	}
+
+	path := r.path(plan.Name.ValueString())
+	owner := modifyPlanOwnerID(ctx, r.client.PathOwners, "vyosconfig_interface_ethernet", req, resp)
+	if conflictOwner, ok := r.client.PathOwners.claim(makePathKey(path), owner); !ok {
+		resp.Diagnostics.AddError(
+			"Configuration path claimed by more than one resource",
+			fmt.Sprintf("%s is already being managed by %s in this plan.", strings.Join(path, " "), conflictOwner),
+		)
+	}
+}
+
+// ImportState imports an ethernet interface by name, e.g.
+// `terraform import vyosconfig_interface_ethernet.wan eth0`.
+func (r *vyosInterfaceEthernetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
+
+func (r *vyosInterfaceEthernetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vyosInterfaceEthernetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ApplyCommands(ctx, []Command{{Op: "delete", Path: r.path(state.Name.ValueString())}}); err != nil {
+		resp.Diagnostics.AddError("Failed to delete ethernet interface configuration", err.Error())
+	}
+}
@@ -0,0 +1,253 @@
+package vyos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vyosNatRuleResource struct {
+	client *APIClient
+}
+
+func NewVyosNatRuleResource() resource.Resource {
+	return &vyosNatRuleResource{}
+}
+
+type vyosNatRuleModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Type               types.String `tfsdk:"type"`
+	RuleNumber         types.Int64  `tfsdk:"rule_number"`
+	OutboundInterface  types.String `tfsdk:"outbound_interface"`
+	InboundInterface   types.String `tfsdk:"inbound_interface"`
+	SourceAddress      types.String `tfsdk:"source_address"`
+	DestinationAddress types.String `tfsdk:"destination_address"`
+	TranslationAddress types.String `tfsdk:"translation_address"`
+	Description        types.String `tfsdk:"description"`
+}
+
+func (r *vyosNatRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "vyosconfig_nat_rule"
+}
+
+func (r *vyosNatRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single `nat <source|destination> rule <number>` entry.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "NAT rule type: source or destination",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rule_number": schema.Int64Attribute{
+				Required:    true,
+				Description: "Rule number within the NAT type",
+			},
+			"outbound_interface": schema.StringAttribute{
+				Optional:    true,
+				Description: "Outbound interface, used for source NAT rules",
+			},
+			"inbound_interface": schema.StringAttribute{
+				Optional:    true,
+				Description: "Inbound interface, used for destination NAT rules",
+			},
+			"source_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source CIDR to match",
+				Validators:  []validator.String{isCIDR()},
+			},
+			"destination_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Destination CIDR to match",
+				Validators:  []validator.String{isCIDR()},
+			},
+			"translation_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "Translated address or 'masquerade'",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Rule description",
+			},
+		},
+	}
+}
+
+func (r *vyosNatRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*APIClient)
+}
+
+func (r *vyosNatRuleResource) path(natType string, ruleNumber int64) []string {
+	return []string{"nat", natType, "rule", fmt.Sprintf("%d", ruleNumber)}
+}
+
+func (r *vyosNatRuleResource) commandsForPlan(plan vyosNatRuleModel) []Command {
+	base := r.path(plan.Type.ValueString(), plan.RuleNumber.ValueInt64())
+	commands := make([]Command, 0)
+
+	if !plan.OutboundInterface.IsNull() && plan.OutboundInterface.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "outbound-interface"), Value: plan.OutboundInterface.ValueString()})
+	}
+
+	if !plan.InboundInterface.IsNull() && plan.InboundInterface.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "inbound-interface"), Value: plan.InboundInterface.ValueString()})
+	}
+
+	if !plan.SourceAddress.IsNull() && plan.SourceAddress.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "source", "address"), Value: plan.SourceAddress.ValueString()})
+	}
+
+	if !plan.DestinationAddress.IsNull() && plan.DestinationAddress.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "destination", "address"), Value: plan.DestinationAddress.ValueString()})
+	}
+
+	if !plan.TranslationAddress.IsNull() && plan.TranslationAddress.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "translation", "address"), Value: plan.TranslationAddress.ValueString()})
+	}
+
+	if !plan.Description.IsNull() && plan.Description.ValueString() != "" {
+		commands = append(commands, Command{Op: "set", Path: append(append([]string{}, base...), "description"), Value: plan.Description.ValueString()})
+	}
+
+	return commands
+}
+
+func (r *vyosNatRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vyosNatRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ApplyCommands(ctx, r.commandsForPlan(plan)); err != nil {
+		resp.Diagnostics.AddError("Failed to apply NAT rule configuration", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(makePathKey(r.path(plan.Type.ValueString(), plan.RuleNumber.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *vyosNatRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vyosNatRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	path := r.path(state.Type.ValueString(), state.RuleNumber.ValueInt64())
+	exists, err := r.client.PathExists(ctx, path)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Error checking NAT rule existence", err.Error())
+		return
+	}
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	config, err := r.client.GetCurrentConfig(ctx, path)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read NAT rule configuration", err.Error())
+		return
+	}
+
+	state.OutboundInterface = stringOrNull(config["outbound-interface"])
+	state.InboundInterface = stringOrNull(config["inbound-interface"])
+	state.Description = stringOrNull(config["description"])
+	state.SourceAddress = stringOrNull(getNestedValue(config, []string{"source", "address"}))
+	state.DestinationAddress = stringOrNull(getNestedValue(config, []string{"destination", "address"}))
+	state.TranslationAddress = stringOrNull(getNestedValue(config, []string{"translation", "address"}))
+	state.ID = types.StringValue(makePathKey(path))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *vyosNatRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vyosNatRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oldPath := r.path(state.Type.ValueString(), state.RuleNumber.ValueInt64())
+	if err := r.client.ApplyCommands(ctx, []Command{{Op: "delete", Path: oldPath}}); err != nil {
+		resp.Diagnostics.AddError("Failed to clear previous NAT rule configuration", err.Error())
+		return
+	}
+
+	if err := r.client.ApplyCommands(ctx, r.commandsForPlan(plan)); err != nil {
+		resp.Diagnostics.AddError("Failed to apply NAT rule configuration", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(makePathKey(r.path(plan.Type.ValueString(), plan.RuleNumber.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// ModifyPlan claims this rule's path in the shared plan-time registry when
+// the provider has plan_dry_run enabled, so it conflicts loudly instead of
+// silently if another vyosconfig_* resource targets the same rule.
+func (r *vyosNatRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !r.client.PlanDryRun || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vyosNatRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Type.IsUnknown() || plan.Type.IsNull() || plan.RuleNumber.IsUnknown() || plan.RuleNumber.IsNull() {
+		return
+	}
+
+	path := r.path(plan.Type.ValueString(), plan.RuleNumber.ValueInt64())
+	owner := modifyPlanOwnerID(ctx, r.client.PathOwners, "vyosconfig_nat_rule", req, resp)
+	if conflictOwner, ok := r.client.PathOwners.claim(makePathKey(path), owner); !ok {
+		resp.Diagnostics.AddError(
+			"Configuration path claimed by more than one resource",
+			fmt.Sprintf("%s is already being managed by %s in this plan.", strings.Join(path, " "), conflictOwner),
+		)
+	}
+}
+
+// ImportState imports a NAT rule via "<type>/<rule_number>", e.g.
+// `terraform import vyosconfig_nat_rule.r source/100`.
+func (r *vyosNatRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	natType, ruleNumber, err := splitRuleImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("expected \"<type>/<rule-number>\": %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), natType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule_number"), ruleNumber)...)
+}
+
+func (r *vyosNatRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vyosNatRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	path := r.path(state.Type.ValueString(), state.RuleNumber.ValueInt64())
+	if err := r.client.ApplyCommands(ctx, []Command{{Op: "delete", Path: path}}); err != nil {
+		resp.Diagnostics.AddError("Failed to delete NAT rule configuration", err.Error())
+	}
+}
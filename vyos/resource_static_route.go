@@ -0,0 +1,253 @@
+package vyos
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vyosStaticRouteResource struct {
+	client *APIClient
+}
+
+func NewVyosStaticRouteResource() resource.Resource {
+	return &vyosStaticRouteResource{}
+}
+
+type vyosStaticRouteModel struct {
+	ID          types.String `tfsdk:"id"`
+	Destination types.String `tfsdk:"destination"`
+	NextHops    types.Set    `tfsdk:"next_hops"`
+	Distance    types.Int64  `tfsdk:"distance"`
+}
+
+func (r *vyosStaticRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "vyosconfig_static_route"
+}
+
+func (r *vyosStaticRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single `protocols static route <prefix>` entry, including its next hops.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"destination": schema.StringAttribute{
+				Required:    true,
+				Description: "Destination prefix in CIDR notation, e.g. 10.0.0.0/24",
+				Validators:  []validator.String{isCIDR()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"next_hops": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "IP addresses of the next hops for this route",
+				Validators:  []validator.Set{isIPAddressSet()},
+			},
+			"distance": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Administrative distance for this route",
+			},
+		},
+	}
+}
+
+func (r *vyosStaticRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*APIClient)
+}
+
+func (r *vyosStaticRouteResource) path(destination string) []string {
+	return []string{"protocols", "static", "route", destination}
+}
+
+func (r *vyosStaticRouteResource) commandsForPlan(plan vyosStaticRouteModel) []Command {
+	base := r.path(plan.Destination.ValueString())
+	commands := make([]Command, 0)
+
+	for _, nextHop := range toStringSliceFromSet(plan.NextHops) {
+		commands = append(commands, Command{Op: "set", Path: append(append(append([]string{}, base...), "next-hop"), nextHop)})
+	}
+
+	if !plan.Distance.IsNull() {
+		for _, nextHop := range toStringSliceFromSet(plan.NextHops) {
+			commands = append(commands, Command{
+				Op:    "set",
+				Path:  append(append(append(append([]string{}, base...), "next-hop"), nextHop), "distance"),
+				Value: fmt.Sprintf("%d", plan.Distance.ValueInt64()),
+			})
+		}
+	}
+
+	return commands
+}
+
+func (r *vyosStaticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vyosStaticRouteModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ApplyCommands(ctx, r.commandsForPlan(plan)); err != nil {
+		resp.Diagnostics.AddError("Failed to apply static route configuration", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(makePathKey(r.path(plan.Destination.ValueString())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *vyosStaticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vyosStaticRouteModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destination := state.Destination.ValueString()
+	exists, err := r.client.PathExists(ctx, r.path(destination))
+	if err != nil {
+		resp.Diagnostics.AddWarning("Error checking route existence", err.Error())
+		return
+	}
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	nextHops, err := r.client.GetNextHops(ctx, r.path(destination))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read static route next hops", err.Error())
+		return
+	}
+
+	nextHopSet, diags := types.SetValueFrom(ctx, types.StringType, nextHops)
+	resp.Diagnostics.Append(diags...)
+	state.NextHops = nextHopSet
+
+	config, err := r.client.GetCurrentConfig(ctx, r.path(destination))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read static route configuration", err.Error())
+		return
+	}
+	state.Distance = routeDistance(config)
+
+	state.ID = types.StringValue(makePathKey(r.path(destination)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *vyosStaticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vyosStaticRouteModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ApplyCommands(ctx, []Command{{Op: "delete", Path: r.path(state.Destination.ValueString())}}); err != nil {
+		resp.Diagnostics.AddError("Failed to clear previous static route configuration", err.Error())
+		return
+	}
+
+	if err := r.client.ApplyCommands(ctx, r.commandsForPlan(plan)); err != nil {
+		resp.Diagnostics.AddError("Failed to apply static route configuration", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(makePathKey(r.path(plan.Destination.ValueString())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// ModifyPlan claims this route's path in the shared plan-time registry when
+// the provider has plan_dry_run enabled, so it conflicts loudly instead of
+// silently if another vyosconfig_* resource targets the same destination.
+func (r *vyosStaticRouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !r.client.PlanDryRun || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vyosStaticRouteModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Destination.IsUnknown() || plan.Destination.IsNull() {
+		return
+	}
+
+	path := r.path(plan.Destination.ValueString())
+	owner := modifyPlanOwnerID(ctx, r.client.PathOwners, "vyosconfig_static_route", req, resp)
+	if conflictOwner, ok := r.client.PathOwners.claim(makePathKey(path), owner); !ok {
+		resp.Diagnostics.AddError(
+			"Configuration path claimed by more than one resource",
+			fmt.Sprintf("%s is already being managed by %s in this plan.", strings.Join(path, " "), conflictOwner),
+		)
+	}
+}
+
+// ImportState imports a static route by its destination prefix, e.g.
+// `terraform import vyosconfig_static_route.r 10.0.0.0/24`. Read then fetches
+// the route's next hops from the router.
+func (r *vyosStaticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("destination"), req.ID)...)
+}
+
+func (r *vyosStaticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vyosStaticRouteModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.ApplyCommands(ctx, []Command{{Op: "delete", Path: r.path(state.Destination.ValueString())}}); err != nil {
+		resp.Diagnostics.AddError("Failed to delete static route configuration", err.Error())
+	}
+}
+
+// routeDistance reads the administrative distance off of this route's
+// configuration. commandsForPlan sets the same distance on every next hop, so
+// it's enough to read it back off of whichever next hop has one set.
+func routeDistance(config map[string]interface{}) types.Int64 {
+	nextHops, ok := config["next-hop"].(map[string]interface{})
+	if !ok {
+		return types.Int64Null()
+	}
+
+	for _, v := range nextHops {
+		nextHop, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		raw, ok := nextHop["distance"]
+		if !ok {
+			continue
+		}
+		distance, err := strconv.ParseInt(fmt.Sprintf("%v", raw), 10, 64)
+		if err != nil {
+			continue
+		}
+		return types.Int64Value(distance)
+	}
+
+	return types.Int64Null()
+}
+
+func toStringSliceFromSet(set types.Set) []string {
+	elements := make([]string, 0, len(set.Elements()))
+	for _, elem := range set.Elements() {
+		elements = append(elements, elem.(types.String).ValueString())
+	}
+	return elements
+}
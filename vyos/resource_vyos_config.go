@@ -112,8 +112,8 @@ func getRouteBasePath(path []string) []string {
 	return path
 }
 
-func (r *vyosConfigResource) hasMultipleNextHops(routePath []string) (bool, error) {
-	config, err := r.client.GetCurrentConfig(routePath)
+func (r *vyosConfigResource) hasMultipleNextHops(ctx context.Context, routePath []string) (bool, error) {
+	config, err := r.client.GetCurrentConfig(ctx, routePath)
 	if err != nil {
 		return false, err
 	}
@@ -176,7 +176,7 @@ func (r *vyosConfigResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	commands := processCommandsForAPI(plan.Commands)
-	if err := r.client.ApplyCommands(commands); err != nil {
+	if err := r.client.ApplyCommands(ctx, commands); err != nil {
 		resp.Diagnostics.AddError("Failed to apply configuration", err.Error())
 		return
 	}
@@ -199,7 +199,7 @@ func (r *vyosConfigResource) Create(ctx context.Context, req resource.CreateRequ
 			currentValue := cmd.Value.ValueString()
 
 			if !(isRoutePath(pathParts) && len(pathParts) == 4 && pathParts[3] == "next-hop") {
-				val, err := r.client.GetPathValue(pathParts)
+				val, err := r.client.GetPathValue(ctx, pathParts)
 				if err == nil && val != "" {
 					currentValue = val
 				}
@@ -260,7 +260,7 @@ func (r *vyosConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 	for i, cmd := range state.Commands {
 		if cmd.Op.ValueString() == "set" {
 			pathParts := toStringSlice(cmd.Path)
-			exists, err := r.client.PathExists(pathParts)
+			exists, err := r.client.PathExists(ctx, pathParts)
 			if err != nil {
 				resp.Diagnostics.AddWarning("Error checking existence", err.Error())
 				continue
@@ -269,7 +269,7 @@ func (r *vyosConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 			if !exists {
 				if IsRoutePath(pathParts) && len(pathParts) >= 4 {
 					routeBasePath := pathParts[:4]
-					routeExists, err := r.client.PathExists(routeBasePath)
+					routeExists, err := r.client.PathExists(ctx, routeBasePath)
 					if err != nil {
 						resp.Diagnostics.AddWarning("Error checking route existence", err.Error())
 					} else if !routeExists {
@@ -288,7 +288,7 @@ func (r *vyosConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 				continue
 			}
 
-			currentValue, err := r.client.GetPathValue(pathParts)
+			currentValue, err := r.client.GetPathValue(ctx, pathParts)
 			if err == nil {
 				terraformValue := cmd.Value.ValueString()
 
@@ -345,7 +345,7 @@ func (r *vyosConfigResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	if len(deleteCommands) > 0 {
-		if err := r.client.ApplyCommands(deleteCommands); err != nil {
+		if err := r.client.ApplyCommands(ctx, deleteCommands); err != nil {
 			resp.Diagnostics.AddError("Failed to delete old configuration", err.Error())
 			return
 		}
@@ -381,7 +381,7 @@ func (r *vyosConfigResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	if len(newCommands) > 0 {
-		if err := r.client.ApplyCommands(newCommands); err != nil {
+		if err := r.client.ApplyCommands(ctx, newCommands); err != nil {
 			resp.Diagnostics.AddError("Failed to apply new configuration", err.Error())
 			return
 		}
@@ -407,7 +407,7 @@ func (r *vyosConfigResource) Update(ctx context.Context, req resource.UpdateRequ
 				currentValue = cmd.Value.ValueString()
 			} else {
 				var err error
-				currentValue, err = r.client.GetPathValue(pathParts)
+				currentValue, err = r.client.GetPathValue(ctx, pathParts)
 				if err != nil {
 					resp.Diagnostics.AddWarning("Error getting current value", err.Error())
 					currentValue = cmd.Value.ValueString()
@@ -430,6 +430,170 @@ func makePathKey(path []string) string {
 	return strings.Join(path, ":")
 }
 
+// ModifyPlan runs a plan-time dry run against the router when the provider
+// has plan_dry_run enabled: it warns about deletes of paths that don't exist,
+// errors when a set would overwrite a path already claimed by another
+// vyosconfig_* resource in this plan, and attaches a diff of the planned
+// change to the parent path.
+func (r *vyosConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !r.client.PlanDryRun || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan vyosConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := modifyPlanOwnerID(ctx, r.client.PathOwners, "vyosconfig_command", req, resp)
+
+	for _, cmd := range plan.Commands {
+		pathParts := toStringSlice(cmd.Path)
+		if len(pathParts) == 0 {
+			continue
+		}
+
+		switch cmd.Op.ValueString() {
+		case "delete":
+			r.checkDelete(ctx, pathParts, resp)
+		case "set":
+			r.checkSet(ctx, owner, pathParts, cmd.Value.ValueString(), resp)
+		}
+	}
+}
+
+func (r *vyosConfigResource) checkDelete(ctx context.Context, pathParts []string, resp *resource.ModifyPlanResponse) {
+	exists, err := r.client.PathExists(ctx, pathParts)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Failed to check path existence during plan-time dry run", err.Error())
+		return
+	}
+	if !exists {
+		resp.Diagnostics.AddWarning(
+			"Delete targets a path that does not exist",
+			fmt.Sprintf("%s: the router has no configuration at this path; the delete will be a no-op.", strings.Join(pathParts, " ")),
+		)
+	}
+}
+
+func (r *vyosConfigResource) checkSet(ctx context.Context, owner string, pathParts []string, value string, resp *resource.ModifyPlanResponse) {
+	pathKey := makePathKey(pathParts)
+	if conflictOwner, ok := r.client.PathOwners.claim(pathKey, owner); !ok {
+		resp.Diagnostics.AddError(
+			"Configuration path claimed by more than one resource",
+			fmt.Sprintf("%s is already being set by %s in this plan; two vyosconfig_* resources should not manage the same path.", strings.Join(pathParts, " "), conflictOwner),
+		)
+		return
+	}
+
+	parent := parentPath(pathParts)
+	before, err := r.client.GetCurrentConfig(ctx, parent)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Failed to read current configuration during plan-time dry run", err.Error())
+		return
+	}
+
+	after := make(map[string]interface{}, len(before))
+	for k, v := range before {
+		after[k] = v
+	}
+
+	leaf := pathParts[len(pathParts)-1]
+	if value != "" {
+		after[leaf] = value
+	} else {
+		after[leaf] = map[string]interface{}{}
+	}
+
+	if diff := unifiedDiff(before, after); diff != "" {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("Planned change at %s", strings.Join(parent, " ")),
+			diff,
+		)
+	}
+}
+
+// flattenConfig walks a showConfig subtree and synthesizes the "set" commands
+// that would recreate it, for use by ImportState. A leaf map (no children)
+// becomes a single set at its own path; a list value becomes one set per
+// element at the same path (matching how addresses are represented); anything
+// else becomes a single set with that scalar as the value.
+func flattenConfig(basePath []string, config map[string]interface{}) []Command {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	commands := make([]Command, 0, len(keys))
+	for _, key := range keys {
+		path := append(append([]string{}, basePath...), key)
+
+		switch v := config[key].(type) {
+		case map[string]interface{}:
+			if len(v) == 0 {
+				commands = append(commands, Command{Op: "set", Path: path})
+			} else {
+				commands = append(commands, flattenConfig(path, v)...)
+			}
+		case []interface{}:
+			for _, item := range v {
+				commands = append(commands, Command{Op: "set", Path: path, Value: fmt.Sprintf("%v", item)})
+			}
+		default:
+			commands = append(commands, Command{Op: "set", Path: path, Value: fmt.Sprintf("%v", v)})
+		}
+	}
+
+	return commands
+}
+
+// ImportState imports an existing `vyosconfig_command` resource. The import ID
+// is a space-separated VyOS configuration path, e.g.
+// "protocols static route 10.0.0.0/24", which is used to fetch the current
+// configuration at that path and synthesize the equivalent commands list.
+func (r *vyosConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	path := strings.Fields(req.ID)
+	if len(path) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"expected a space-separated VyOS configuration path, e.g. \"protocols static route 10.0.0.0/24\"",
+		)
+		return
+	}
+
+	config, err := r.client.GetCurrentConfig(ctx, path)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read configuration for import", err.Error())
+		return
+	}
+
+	apiCommands := flattenConfig(path, config)
+	state := vyosConfigModel{
+		Commands: make([]vyosCommandModel, len(apiCommands)),
+	}
+
+	for i, cmd := range apiCommands {
+		pathList, diags := types.ListValueFrom(ctx, types.StringType, cmd.Path)
+		resp.Diagnostics.Append(diags...)
+
+		value := types.StringValue(cmd.Value)
+		if cmd.Op != "set" {
+			value = types.StringNull()
+		}
+
+		state.Commands[i] = vyosCommandModel{
+			Op:    types.StringValue(cmd.Op),
+			Path:  pathList,
+			Value: value,
+		}
+	}
+
+	state.ID = types.StringValue(generateConfigID(state.Commands))
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
 func (r *vyosConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state vyosConfigModel
 	diags := req.State.Get(ctx, &state)
@@ -471,7 +635,7 @@ func (r *vyosConfigResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 	sortedCommands := sortCommandsByPathDepth(deleteCommands)
 
-	if err := r.client.ApplyCommands(sortedCommands); err != nil {
+	if err := r.client.ApplyCommands(ctx, sortedCommands); err != nil {
 		resp.Diagnostics.AddError("Falha ao excluir configuração", err.Error())
 	}
 }
@@ -0,0 +1,170 @@
+package vyos
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// cidrValidator ensures a string attribute is a valid CIDR network, e.g. "10.0.0.0/24".
+type cidrValidator struct{}
+
+func isCIDR() validator.String {
+	return cidrValidator{}
+}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	return "value must be a valid CIDR network (e.g. 10.0.0.0/24)"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("%q is not a valid CIDR network: %s", value, err),
+		)
+	}
+}
+
+// cidrListValidator ensures every element of a list attribute is a valid CIDR network.
+type cidrListValidator struct{}
+
+func isCIDRList() validator.List {
+	return cidrListValidator{}
+}
+
+func (v cidrListValidator) Description(ctx context.Context) string {
+	return "every element must be a valid CIDR network (e.g. 10.0.0.0/24)"
+}
+
+func (v cidrListValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrListValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, elem := range toStringSlice(req.ConfigValue) {
+		if _, _, err := net.ParseCIDR(elem); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid CIDR",
+				fmt.Sprintf("%q is not a valid CIDR network: %s", elem, err),
+			)
+		}
+	}
+}
+
+// ipAddressValidator ensures a string attribute is a valid IPv4 or IPv6 address.
+type ipAddressValidator struct{}
+
+func isIPAddress() validator.String {
+	return ipAddressValidator{}
+}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	return "value must be a valid IP address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP address",
+			fmt.Sprintf("%q is not a valid IP address", value),
+		)
+	}
+}
+
+// ipAddressSetValidator ensures every element of a set attribute is a valid IP address.
+type ipAddressSetValidator struct{}
+
+func isIPAddressSet() validator.Set {
+	return ipAddressSetValidator{}
+}
+
+func (v ipAddressSetValidator) Description(ctx context.Context) string {
+	return "every element must be a valid IP address"
+}
+
+func (v ipAddressSetValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressSetValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, elem := range toStringSliceFromSet(req.ConfigValue) {
+		if net.ParseIP(elem) == nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid IP address",
+				fmt.Sprintf("%q is not a valid IP address", elem),
+			)
+		}
+	}
+}
+
+// portOrRangeValidator ensures a string attribute is a single port ("443") or a
+// VyOS-style port range ("8000-8080").
+type portOrRangeValidator struct{}
+
+func isPortOrRange() validator.String {
+	return portOrRangeValidator{}
+}
+
+func (v portOrRangeValidator) Description(ctx context.Context) string {
+	return "value must be a port number (1-65535) or a range (e.g. 8000-8080)"
+}
+
+func (v portOrRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portOrRangeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	parts := strings.SplitN(value, "-", 2)
+
+	for _, part := range parts {
+		port, err := strconv.Atoi(part)
+		if err != nil || port < 1 || port > 65535 {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid port",
+				fmt.Sprintf("%q is not a valid port number or range", value),
+			)
+			return
+		}
+	}
+}